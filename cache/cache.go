@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nishanthgowda/btree/lru/arc"
+	bufferpool "github.com/nishanthgowda/btree/lru/bufferpool-lru"
+	"github.com/nishanthgowda/btree/lru/lru"
+)
+
+// Source loads a value for a key that is missing from a Cache. It is the
+// single point where callers plug in whatever backs the cache - a
+// database, a remote service, a file on disk.
+type Source[K comparable, V any] interface {
+	Load(ctx context.Context, k K) (V, error)
+}
+
+// Cache is a loader-backed front end over one of the eviction policies in
+// this repo. Acquire never returns a miss: it loads through the Source on
+// the caller's behalf and coalesces concurrent misses for the same key.
+// Callers that hold onto the returned value must call Release when done so
+// the entry becomes eligible for eviction again.
+type Cache[K comparable, V any] interface {
+	Acquire(ctx context.Context, k K) (V, error)
+	Release(k K)
+	Flush(ctx context.Context) error
+}
+
+// backingStore is the common shape of LRUCache, BufferPool, and ARCCache.
+type backingStore[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+}
+
+// pinEntry holds a value that Acquire has handed out at least once without
+// a matching Release. Pinned entries live here rather than relying on the
+// backing store's own eviction, so a pinned value is never lost even if
+// the backing store evicts its copy under capacity pressure.
+type pinEntry[V any] struct {
+	value    V
+	refcount int
+}
+
+type wrapped[K comparable, V any] struct {
+	mu     sync.Mutex
+	store  backingStore[K, V]
+	source Source[K, V]
+	group  singleflightGroup[K, V]
+	pinned map[K]pinEntry[V]
+}
+
+func newWrapped[K comparable, V any](store backingStore[K, V], source Source[K, V]) Cache[K, V] {
+	return &wrapped[K, V]{
+		store:  store,
+		source: source,
+		pinned: make(map[K]pinEntry[V]),
+	}
+}
+
+// WrapLRU adds loader-backed Acquire/Release semantics on top of an
+// existing LRUCache.
+func WrapLRU[K comparable, V any](c *lru.LRUCache[K, V], source Source[K, V]) Cache[K, V] {
+	return newWrapped[K, V](c, source)
+}
+
+// WrapBufferPool adds loader-backed Acquire/Release semantics on top of an
+// existing BufferPool.
+func WrapBufferPool[K comparable, V any](c *bufferpool.BufferPool[K, V], source Source[K, V]) Cache[K, V] {
+	return newWrapped[K, V](c, source)
+}
+
+// WrapARC adds loader-backed Acquire/Release semantics on top of an
+// existing ARCCache.
+func WrapARC[K comparable, V any](c *arc.ARCCache[K, V], source Source[K, V]) Cache[K, V] {
+	return newWrapped[K, V](c, source)
+}
+
+func (w *wrapped[K, V]) Acquire(ctx context.Context, k K) (V, error) {
+	w.mu.Lock()
+	if entry, ok := w.pinned[k]; ok {
+		entry.refcount++
+		w.pinned[k] = entry
+		w.mu.Unlock()
+		return entry.value, nil
+	}
+
+	if v, ok := w.store.Get(k); ok {
+		w.pinned[k] = pinEntry[V]{value: v, refcount: 1}
+		w.mu.Unlock()
+		return v, nil
+	}
+	w.mu.Unlock()
+
+	v, err := w.group.do(k, func() (V, error) {
+		// A caller that missed above can reach here after an earlier load
+		// for k has finished; that load stored its value before leaving the
+		// group, so reuse it instead of loading again.
+		w.mu.Lock()
+		if v, ok := w.store.Get(k); ok {
+			w.mu.Unlock()
+			return v, nil
+		}
+		w.mu.Unlock()
+
+		v, err := w.source.Load(ctx, k)
+		if err == nil {
+			w.mu.Lock()
+			w.store.Put(k, v)
+			w.mu.Unlock()
+		}
+		return v, err
+	})
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+
+	w.mu.Lock()
+	if entry, ok := w.pinned[k]; ok {
+		// Someone else already loaded and pinned this key while we were
+		// waiting on the singleflight call; join their pin instead of
+		// installing a second one.
+		entry.refcount++
+		w.pinned[k] = entry
+	} else {
+		w.pinned[k] = pinEntry[V]{value: v, refcount: 1}
+	}
+	w.mu.Unlock()
+
+	return v, nil
+}
+
+func (w *wrapped[K, V]) Release(k K) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	entry, ok := w.pinned[k]
+	if !ok {
+		return
+	}
+
+	entry.refcount--
+	if entry.refcount <= 0 {
+		delete(w.pinned, k)
+		return
+	}
+	w.pinned[k] = entry
+}
+
+// Flush is a no-op: Source is read-only (Load has no write-back
+// counterpart), so there is nothing dirty to persist. It exists so callers
+// can program against Cache uniformly.
+func (w *wrapped[K, V]) Flush(ctx context.Context) error {
+	return nil
+}
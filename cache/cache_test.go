@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nishanthgowda/btree/lru/lru"
+)
+
+// countingSource counts how many times Load actually runs, so tests can
+// assert that singleflight coalesced concurrent misses into one call.
+type countingSource struct {
+	loads int32
+}
+
+func (s *countingSource) Load(ctx context.Context, k int) (string, error) {
+	atomic.AddInt32(&s.loads, 1)
+	return "loaded", nil
+}
+
+func TestWrapLRU_AcquireLoadsOnMiss(t *testing.T) {
+	src := &countingSource{}
+	c := WrapLRU(lru.NewLRUCache[int, string](10), src)
+
+	v, err := c.Acquire(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "loaded" {
+		t.Fatalf("expected \"loaded\", got %q", v)
+	}
+	if src.loads != 1 {
+		t.Fatalf("expected exactly 1 load, got %d", src.loads)
+	}
+
+	// A second Acquire should hit the cache, not the source.
+	if _, err := c.Acquire(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if src.loads != 1 {
+		t.Fatalf("expected load count to stay at 1 after a cache hit, got %d", src.loads)
+	}
+}
+
+func TestWrapLRU_AcquireCoalescesConcurrentMisses(t *testing.T) {
+	src := &countingSource{}
+	c := WrapLRU(lru.NewLRUCache[int, string](10), src)
+
+	const n = 20
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			if _, err := c.Acquire(context.Background(), 42); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if src.loads != 1 {
+		t.Fatalf("expected singleflight to collapse %d concurrent misses into 1 load, got %d", n, src.loads)
+	}
+}
+
+func TestWrapLRU_ReleaseDropsPin(t *testing.T) {
+	src := &countingSource{}
+	c := WrapLRU(lru.NewLRUCache[int, string](10), src)
+
+	if _, err := c.Acquire(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c.Release(1)
+
+	w := c.(*wrapped[int, string])
+	if _, pinned := w.pinned[1]; pinned {
+		t.Fatalf("expected key 1 to be unpinned after Release")
+	}
+}
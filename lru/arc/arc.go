@@ -0,0 +1,198 @@
+package arc
+
+import (
+	"sync"
+
+	doublyll "github.com/nishanthgowda/btree/lru/doubly-ll"
+)
+
+// ARCCache implements the Adaptive Replacement Cache algorithm. It tracks
+// four lists built on top of doublyll.DoublyLinkedList:
+//
+//	T1 - recent, resident entries
+//	T2 - frequent, resident entries
+//	B1 - ghost history of recently evicted T1 entries (keys only)
+//	B2 - ghost history of recently evicted T2 entries (keys only)
+//
+// p is the adaptive target size of T1, tuned on every ghost hit so the
+// cache leans toward whichever list (recency or frequency) is paying off.
+type ARCCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	p        int
+
+	t1 *doublyll.DoublyLinkedList[K, V]
+	t2 *doublyll.DoublyLinkedList[K, V]
+	b1 *doublyll.DoublyLinkedList[K, V]
+	b2 *doublyll.DoublyLinkedList[K, V]
+
+	t1m map[K]*doublyll.Node[K, V]
+	t2m map[K]*doublyll.Node[K, V]
+	b1m map[K]*doublyll.Node[K, V]
+	b2m map[K]*doublyll.Node[K, V]
+}
+
+func NewARCCache[K comparable, V any](capacity int) *ARCCache[K, V] {
+	return &ARCCache[K, V]{
+		capacity: capacity,
+		t1:       doublyll.NewDoublyLinkedList[K, V](),
+		t2:       doublyll.NewDoublyLinkedList[K, V](),
+		b1:       doublyll.NewDoublyLinkedList[K, V](),
+		b2:       doublyll.NewDoublyLinkedList[K, V](),
+		t1m:      make(map[K]*doublyll.Node[K, V]),
+		t2m:      make(map[K]*doublyll.Node[K, V]),
+		b1m:      make(map[K]*doublyll.Node[K, V]),
+		b2m:      make(map[K]*doublyll.Node[K, V]),
+	}
+}
+
+func (a *ARCCache[K, V]) Get(key K) (V, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if node, ok := a.t1m[key]; ok {
+		a.t1.RemoveNode(node)
+		delete(a.t1m, key)
+		a.t2.AddFront(node)
+		a.t2m[key] = node
+		return node.Value, true
+	}
+
+	if node, ok := a.t2m[key]; ok {
+		a.t2.RemoveNode(node)
+		a.t2.AddFront(node)
+		return node.Value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+func (a *ARCCache[K, V]) Put(key K, value V) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Already resident in T1: a second reference promotes it to T2.
+	if node, ok := a.t1m[key]; ok {
+		node.Value = value
+		a.t1.RemoveNode(node)
+		delete(a.t1m, key)
+		a.t2.AddFront(node)
+		a.t2m[key] = node
+		return
+	}
+
+	// Already resident in T2: update in place at the MRU end.
+	if node, ok := a.t2m[key]; ok {
+		node.Value = value
+		a.t2.RemoveNode(node)
+		a.t2.AddFront(node)
+		return
+	}
+
+	// Ghost hit in B1: grow p toward recency, then move to T2.
+	if node, ok := a.b1m[key]; ok {
+		delta := 1
+		if len(a.b1m) > 0 {
+			if d := len(a.b2m) / len(a.b1m); d > delta {
+				delta = d
+			}
+		}
+		a.p += delta
+		if a.p > a.capacity {
+			a.p = a.capacity
+		}
+		a.replace(a.p, false)
+
+		a.b1.RemoveNode(node)
+		delete(a.b1m, key)
+		node.Value = value
+		a.t2.AddFront(node)
+		a.t2m[key] = node
+		return
+	}
+
+	// Ghost hit in B2: shrink p toward frequency, then move to T2.
+	if node, ok := a.b2m[key]; ok {
+		delta := 1
+		if len(a.b2m) > 0 {
+			if d := len(a.b1m) / len(a.b2m); d > delta {
+				delta = d
+			}
+		}
+		a.p -= delta
+		if a.p < 0 {
+			a.p = 0
+		}
+		a.replace(a.p, true)
+
+		a.b2.RemoveNode(node)
+		delete(a.b2m, key)
+		node.Value = value
+		a.t2.AddFront(node)
+		a.t2m[key] = node
+		return
+	}
+
+	// Brand new key.
+	t1Len, b1Len := len(a.t1m), len(a.b1m)
+	t2Len, b2Len := len(a.t2m), len(a.b2m)
+
+	if t1Len+b1Len == a.capacity {
+		if t1Len < a.capacity {
+			ghost := a.b1.Tail
+			a.b1.RemoveNode(ghost)
+			delete(a.b1m, ghost.Key)
+			a.replace(a.p, false)
+		} else {
+			node := a.t1.Tail
+			a.t1.RemoveNode(node)
+			delete(a.t1m, node.Key)
+		}
+	} else if t1Len+t2Len+b1Len+b2Len >= a.capacity {
+		if t1Len+t2Len+b1Len+b2Len == 2*a.capacity {
+			ghost := a.b2.Tail
+			a.b2.RemoveNode(ghost)
+			delete(a.b2m, ghost.Key)
+		}
+		a.replace(a.p, false)
+	}
+
+	newNode := &doublyll.Node[K, V]{Key: key, Value: value}
+	a.t1.AddFront(newNode)
+	a.t1m[key] = newNode
+}
+
+// replace evicts the LRU entry of T1 to the MRU of B1 when T1 has reached
+// its target p (at least 1) or a B2 ghost hit pinned it there, otherwise evicts
+// the LRU entry of T2 to the MRU of B2.
+func (a *ARCCache[K, V]) replace(p int, keyInB2 bool) {
+	t1Len := len(a.t1m)
+
+	target := p
+	if target < 1 {
+		target = 1
+	}
+
+	if t1Len > 0 && (t1Len >= target || (keyInB2 && t1Len == p)) {
+		node := a.t1.Tail
+		a.t1.RemoveNode(node)
+		delete(a.t1m, node.Key)
+
+		var zero V
+		node.Value = zero
+		a.b1.AddFront(node)
+		a.b1m[node.Key] = node
+		return
+	}
+
+	if node := a.t2.Tail; node != nil {
+		a.t2.RemoveNode(node)
+		delete(a.t2m, node.Key)
+
+		var zero V
+		node.Value = zero
+		a.b2.AddFront(node)
+		a.b2m[node.Key] = node
+	}
+}
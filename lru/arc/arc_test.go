@@ -0,0 +1,79 @@
+package arc
+
+import "testing"
+
+// TestARCCache_ScanResistance simulates a one-off sequential scan over keys
+// that are never revisited, interleaved with a small hot set that is
+// accessed repeatedly. A plain LRU would let the scan evict the hot set;
+// ARC should keep the hot keys resident because they build up frequency in
+// T2 while the scan churns through T1/B1.
+func TestARCCache_ScanResistance(t *testing.T) {
+	cache := NewARCCache[int, int](10)
+
+	hotKeys := []int{1, 2, 3}
+	for _, k := range hotKeys {
+		cache.Put(k, k)
+	}
+
+	// Warm the hot keys into T2 (frequent).
+	for i := 0; i < 5; i++ {
+		for _, k := range hotKeys {
+			cache.Get(k)
+		}
+	}
+
+	// A long one-time scan over keys that are never revisited.
+	for i := 100; i < 200; i++ {
+		cache.Put(i, i)
+	}
+
+	for _, k := range hotKeys {
+		if _, ok := cache.Get(k); !ok {
+			t.Errorf("expected hot key %d to survive the scan, but it was evicted", k)
+		}
+	}
+}
+
+// TestARCCache_MixedWorkload exercises a mix of fresh puts, re-reads, and
+// ghost-list hits (re-inserting a key shortly after it was evicted) and
+// checks the cache stays internally consistent.
+func TestARCCache_MixedWorkload(t *testing.T) {
+	cache := NewARCCache[int, int](4)
+
+	cache.Put(1, 10)
+	cache.Put(2, 20)
+	cache.Put(3, 30)
+	cache.Put(4, 40)
+
+	if v, ok := cache.Get(1); !ok || v != 10 {
+		t.Fatalf("expected key 1 to be resident with value 10, got (%d, %v)", v, ok)
+	}
+
+	// Evict key 2 via capacity pressure; it should land in a ghost list.
+	cache.Put(5, 50)
+
+	if _, ok := cache.Get(2); ok {
+		t.Fatalf("expected key 2 to have been evicted")
+	}
+
+	// Re-inserting key 2 should hit a ghost list and adapt p rather than
+	// behaving like an ordinary cold miss.
+	cache.Put(2, 21)
+
+	if v, ok := cache.Get(2); !ok || v != 21 {
+		t.Fatalf("expected key 2 to be resident again with value 21, got (%d, %v)", v, ok)
+	}
+}
+
+// TestARCCache_UpdateExisting checks that re-putting a resident key updates
+// its value.
+func TestARCCache_UpdateExisting(t *testing.T) {
+	cache := NewARCCache[string, int](2)
+
+	cache.Put("a", 1)
+	cache.Put("a", 2)
+
+	if v, ok := cache.Get("a"); !ok || v != 2 {
+		t.Fatalf("expected key \"a\" to be updated to 2, got (%d, %v)", v, ok)
+	}
+}
@@ -1,10 +1,13 @@
 package lru_test
 
 import (
+	"sync/atomic"
 	"testing"
 
 	bufferpool "github.com/nishanthgowda/btree/lru/bufferpool-lru"
 	"github.com/nishanthgowda/btree/lru/lru"
+	"github.com/nishanthgowda/btree/lru/sharded"
+	"github.com/nishanthgowda/btree/lru/sieve"
 )
 
 // Benchmark scenarios to test:
@@ -16,7 +19,7 @@ import (
 
 // BenchmarkNormalLRU_SequentialWrites tests sequential writes with no eviction
 func BenchmarkNormalLRU_SequentialWrites(b *testing.B) {
-	cache := lru.NewLRUCache(1000)
+	cache := lru.NewLRUCache[int, int](1000)
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -26,7 +29,7 @@ func BenchmarkNormalLRU_SequentialWrites(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_SequentialWrites tests sequential writes with no eviction
 func BenchmarkBufferPoolLRU_SequentialWrites(b *testing.B) {
-	cache := bufferpool.NewBufferPool(1000, 0.7) // 70% old list
+	cache := bufferpool.NewBufferPool[int, int](1000, 0.7) // 70% old list
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -36,7 +39,7 @@ func BenchmarkBufferPoolLRU_SequentialWrites(b *testing.B) {
 
 // BenchmarkNormalLRU_WithEviction tests sequential writes that trigger evictions
 func BenchmarkNormalLRU_WithEviction(b *testing.B) {
-	cache := lru.NewLRUCache(100)
+	cache := lru.NewLRUCache[int, int](100)
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -46,7 +49,7 @@ func BenchmarkNormalLRU_WithEviction(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_WithEviction tests sequential writes that trigger evictions
 func BenchmarkBufferPoolLRU_WithEviction(b *testing.B) {
-	cache := bufferpool.NewBufferPool(100, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](100, 0.7)
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -56,7 +59,7 @@ func BenchmarkBufferPoolLRU_WithEviction(b *testing.B) {
 
 // BenchmarkNormalLRU_CacheHits tests read performance with 100% cache hits
 func BenchmarkNormalLRU_CacheHits(b *testing.B) {
-	cache := lru.NewLRUCache(1000)
+	cache := lru.NewLRUCache[int, int](1000)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -71,7 +74,7 @@ func BenchmarkNormalLRU_CacheHits(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_CacheHits tests read performance with 100% cache hits
 func BenchmarkBufferPoolLRU_CacheHits(b *testing.B) {
-	cache := bufferpool.NewBufferPool(1000, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](1000, 0.7)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -86,7 +89,7 @@ func BenchmarkBufferPoolLRU_CacheHits(b *testing.B) {
 
 // BenchmarkNormalLRU_CacheMisses tests read performance with 100% cache misses
 func BenchmarkNormalLRU_CacheMisses(b *testing.B) {
-	cache := lru.NewLRUCache(100)
+	cache := lru.NewLRUCache[int, int](100)
 
 	// Pre-populate cache with different keys
 	for i := 0; i < 100; i++ {
@@ -101,7 +104,7 @@ func BenchmarkNormalLRU_CacheMisses(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_CacheMisses tests read performance with 100% cache misses
 func BenchmarkBufferPoolLRU_CacheMisses(b *testing.B) {
-	cache := bufferpool.NewBufferPool(100, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](100, 0.7)
 
 	// Pre-populate cache with different keys
 	for i := 0; i < 100; i++ {
@@ -116,7 +119,7 @@ func BenchmarkBufferPoolLRU_CacheMisses(b *testing.B) {
 
 // BenchmarkNormalLRU_MixedOps tests mixed read/write operations
 func BenchmarkNormalLRU_MixedOps(b *testing.B) {
-	cache := lru.NewLRUCache(1000)
+	cache := lru.NewLRUCache[int, int](1000)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -135,7 +138,7 @@ func BenchmarkNormalLRU_MixedOps(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_MixedOps tests mixed read/write operations
 func BenchmarkBufferPoolLRU_MixedOps(b *testing.B) {
-	cache := bufferpool.NewBufferPool(1000, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](1000, 0.7)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -155,7 +158,7 @@ func BenchmarkBufferPoolLRU_MixedOps(b *testing.B) {
 // BenchmarkNormalLRU_HighLocality simulates high locality access pattern (80/20 rule)
 // 80% of accesses go to 20% of the data
 func BenchmarkNormalLRU_HighLocality(b *testing.B) {
-	cache := lru.NewLRUCache(1000)
+	cache := lru.NewLRUCache[int, int](1000)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -176,7 +179,7 @@ func BenchmarkNormalLRU_HighLocality(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_HighLocality simulates high locality access pattern (80/20 rule)
 func BenchmarkBufferPoolLRU_HighLocality(b *testing.B) {
-	cache := bufferpool.NewBufferPool(1000, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](1000, 0.7)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -197,7 +200,7 @@ func BenchmarkBufferPoolLRU_HighLocality(b *testing.B) {
 
 // BenchmarkNormalLRU_UpdateExisting tests performance when updating existing keys
 func BenchmarkNormalLRU_UpdateExisting(b *testing.B) {
-	cache := lru.NewLRUCache(1000)
+	cache := lru.NewLRUCache[int, int](1000)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -212,7 +215,7 @@ func BenchmarkNormalLRU_UpdateExisting(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_UpdateExisting tests performance when updating existing keys
 func BenchmarkBufferPoolLRU_UpdateExisting(b *testing.B) {
-	cache := bufferpool.NewBufferPool(1000, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](1000, 0.7)
 
 	// Pre-populate cache
 	for i := 0; i < 1000; i++ {
@@ -227,7 +230,7 @@ func BenchmarkBufferPoolLRU_UpdateExisting(b *testing.B) {
 
 // BenchmarkNormalLRU_SmallCache tests performance with a very small cache (high eviction rate)
 func BenchmarkNormalLRU_SmallCache(b *testing.B) {
-	cache := lru.NewLRUCache(10)
+	cache := lru.NewLRUCache[int, int](10)
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -237,7 +240,7 @@ func BenchmarkNormalLRU_SmallCache(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_SmallCache tests performance with a very small cache (high eviction rate)
 func BenchmarkBufferPoolLRU_SmallCache(b *testing.B) {
-	cache := bufferpool.NewBufferPool(10, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](10, 0.7)
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -247,7 +250,7 @@ func BenchmarkBufferPoolLRU_SmallCache(b *testing.B) {
 
 // BenchmarkNormalLRU_LargeCache tests performance with a large cache
 func BenchmarkNormalLRU_LargeCache(b *testing.B) {
-	cache := lru.NewLRUCache(10000)
+	cache := lru.NewLRUCache[int, int](10000)
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -257,10 +260,185 @@ func BenchmarkNormalLRU_LargeCache(b *testing.B) {
 
 // BenchmarkBufferPoolLRU_LargeCache tests performance with a large cache
 func BenchmarkBufferPoolLRU_LargeCache(b *testing.B) {
-	cache := bufferpool.NewBufferPool(10000, 0.7)
+	cache := bufferpool.NewBufferPool[int, int](10000, 0.7)
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
 		cache.Put(i%10000, i)
 	}
 }
+
+// BenchmarkSieve_SequentialWrites tests sequential writes with no eviction
+func BenchmarkSieve_SequentialWrites(b *testing.B) {
+	cache := sieve.NewSieveCache[int, int](1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cache.Put(i%1000, i)
+	}
+}
+
+// BenchmarkSieve_WithEviction tests sequential writes that trigger evictions
+func BenchmarkSieve_WithEviction(b *testing.B) {
+	cache := sieve.NewSieveCache[int, int](100)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cache.Put(i, i)
+	}
+}
+
+// BenchmarkSieve_CacheHits tests read performance with 100% cache hits
+func BenchmarkSieve_CacheHits(b *testing.B) {
+	cache := sieve.NewSieveCache[int, int](1000)
+
+	// Pre-populate cache
+	for i := 0; i < 1000; i++ {
+		cache.Put(i, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Get(i % 1000)
+	}
+}
+
+// BenchmarkSieve_CacheMisses tests read performance with 100% cache misses
+func BenchmarkSieve_CacheMisses(b *testing.B) {
+	cache := sieve.NewSieveCache[int, int](100)
+
+	// Pre-populate cache with different keys
+	for i := 0; i < 100; i++ {
+		cache.Put(i, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Get(i + 1000) // These keys don't exist
+	}
+}
+
+// BenchmarkSieve_MixedOps tests mixed read/write operations
+func BenchmarkSieve_MixedOps(b *testing.B) {
+	cache := sieve.NewSieveCache[int, int](1000)
+
+	// Pre-populate cache
+	for i := 0; i < 1000; i++ {
+		cache.Put(i, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if i%2 == 0 {
+			cache.Get(i % 1000)
+		} else {
+			cache.Put(i%1000, i)
+		}
+	}
+}
+
+// BenchmarkSieve_HighLocality simulates high locality access pattern (80/20 rule)
+// 80% of accesses go to 20% of the data
+func BenchmarkSieve_HighLocality(b *testing.B) {
+	cache := sieve.NewSieveCache[int, int](1000)
+
+	// Pre-populate cache
+	for i := 0; i < 1000; i++ {
+		cache.Put(i, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if i%10 < 8 {
+			// 80% of accesses to first 200 items (20% of cache)
+			cache.Get(i % 200)
+		} else {
+			// 20% of accesses to remaining 800 items
+			cache.Get(200 + (i % 800))
+		}
+	}
+}
+
+// newShardedLRU builds a sharded LRU of int/int shards, each with its own
+// capacity, for the sharding benchmarks below.
+func newShardedLRU(shards int) sharded.Cache[int, int] {
+	return sharded.NewShardedCache[int, int](shards, 1000, func(cap int) sharded.Cache[int, int] {
+		return lru.NewLRUCache[int, int](cap)
+	})
+}
+
+// benchShardedSequentialWrites drives concurrent writers across the given
+// number of shards to show the lock-contention win as shard count grows.
+func benchShardedSequentialWrites(b *testing.B, shards int) {
+	cache := newShardedLRU(shards)
+	var counter int64
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := int(atomic.AddInt64(&counter, 1))
+		for pb.Next() {
+			cache.Put(i%1000, i)
+			i++
+		}
+	})
+}
+
+func BenchmarkSharded_SequentialWrites_1Shard(b *testing.B)  { benchShardedSequentialWrites(b, 1) }
+func BenchmarkSharded_SequentialWrites_8Shards(b *testing.B) { benchShardedSequentialWrites(b, 8) }
+func BenchmarkSharded_SequentialWrites_64Shards(b *testing.B) {
+	benchShardedSequentialWrites(b, 64)
+}
+
+// benchShardedMixedOps drives concurrent readers and writers across the
+// given number of shards.
+func benchShardedMixedOps(b *testing.B, shards int) {
+	cache := newShardedLRU(shards)
+	for i := 0; i < 1000; i++ {
+		cache.Put(i, i)
+	}
+	var counter int64
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := int(atomic.AddInt64(&counter, 1))
+		for pb.Next() {
+			if i%2 == 0 {
+				cache.Get(i % 1000)
+			} else {
+				cache.Put(i%1000, i)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSharded_MixedOps_1Shard(b *testing.B)   { benchShardedMixedOps(b, 1) }
+func BenchmarkSharded_MixedOps_8Shards(b *testing.B)  { benchShardedMixedOps(b, 8) }
+func BenchmarkSharded_MixedOps_64Shards(b *testing.B) { benchShardedMixedOps(b, 64) }
+
+// benchShardedHighLocality drives concurrent 80/20 access across the given
+// number of shards.
+func benchShardedHighLocality(b *testing.B, shards int) {
+	cache := newShardedLRU(shards)
+	for i := 0; i < 1000; i++ {
+		cache.Put(i, i)
+	}
+	var counter int64
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := int(atomic.AddInt64(&counter, 1))
+		for pb.Next() {
+			if i%10 < 8 {
+				cache.Get(i % 200)
+			} else {
+				cache.Get(200 + (i % 800))
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSharded_HighLocality_1Shard(b *testing.B)   { benchShardedHighLocality(b, 1) }
+func BenchmarkSharded_HighLocality_8Shards(b *testing.B)  { benchShardedHighLocality(b, 8) }
+func BenchmarkSharded_HighLocality_64Shards(b *testing.B) { benchShardedHighLocality(b, 64) }
@@ -1,31 +1,103 @@
 package bufferpool_lru
 
-import doublyll "github.com/nishanthgowda/btree/lru/doubly-ll"
+import (
+	"sync"
+	"time"
 
-type BufferPool struct {
+	doublyll "github.com/nishanthgowda/btree/lru/doubly-ll"
+)
+
+// EvictReason identifies why an entry left the cache, so an OnEvict
+// callback can tell a capacity eviction apart from an expired or manually
+// removed one.
+type EvictReason int
+
+const (
+	EvictCapacity EvictReason = iota
+	EvictExpired
+	EvictManual
+)
+
+// Option configures a BufferPool at construction time.
+type Option[K comparable, V any] func(*BufferPool[K, V])
+
+// WithDefaultTTL makes every Put (not just PutWithTTL) expire after d.
+func WithDefaultTTL[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(bp *BufferPool[K, V]) {
+		bp.defaultTTL = d
+	}
+}
+
+// WithOnEvict registers a callback fired whenever an entry leaves the
+// cache, whether from capacity pressure, expiration, or Delete.
+func WithOnEvict[K comparable, V any](fn func(key K, value V, reason EvictReason)) Option[K, V] {
+	return func(bp *BufferPool[K, V]) {
+		bp.onEvict = fn
+	}
+}
+
+// WithJanitor starts a background goroutine that sweeps the whole list,
+// from the tail, for expired entries every interval, so a cache under TTL but low
+// read pressure doesn't stay bloated with dead entries until something
+// happens to Get them. Call Close to stop it.
+func WithJanitor[K comparable, V any](interval time.Duration) Option[K, V] {
+	return func(bp *BufferPool[K, V]) {
+		bp.janitorInterval = interval
+	}
+}
+
+type BufferPool[K comparable, V any] struct {
+	mu             sync.Mutex
 	capacity       int
-	cache          map[int]*doublyll.Node
-	list           *doublyll.DoublyLinkedList
-	MidPoint       *doublyll.Node
+	cache          map[K]*doublyll.Node[K, V]
+	list           *doublyll.DoublyLinkedList[K, V]
+	MidPoint       *doublyll.Node[K, V]
 	OldRatio       float64
 	MaxOldSize     int // Renamed from OldListSize for clarity (The Target)
 	currentOldSize int // The actual counter
+
+	defaultTTL      time.Duration
+	onEvict         func(key K, value V, reason EvictReason)
+	janitorInterval time.Duration
+	stopJanitor     chan struct{}
+	closeOnce       sync.Once
 }
 
-func NewBufferPool(capacity int, ratio float64) *BufferPool {
-	return &BufferPool{
+func NewBufferPool[K comparable, V any](capacity int, ratio float64, opts ...Option[K, V]) *BufferPool[K, V] {
+	bp := &BufferPool[K, V]{
 		capacity:       capacity,
-		cache:          make(map[int]*doublyll.Node),
-		list:           doublyll.NewDoublyLinkedList(),
+		cache:          make(map[K]*doublyll.Node[K, V]),
+		list:           doublyll.NewDoublyLinkedList[K, V](),
 		MidPoint:       nil,
 		OldRatio:       ratio,
 		MaxOldSize:     int(float64(capacity) * ratio),
 		currentOldSize: 0,
 	}
+
+	for _, opt := range opts {
+		opt(bp)
+	}
+
+	if bp.janitorInterval > 0 {
+		bp.stopJanitor = make(chan struct{})
+		go bp.runJanitor()
+	}
+
+	return bp
+}
+
+// Close stops the background janitor goroutine, if one was started with
+// WithJanitor. It is safe to call even if no janitor is running.
+func (bp *BufferPool[K, V]) Close() {
+	bp.closeOnce.Do(func() {
+		if bp.stopJanitor != nil {
+			close(bp.stopJanitor)
+		}
+	})
 }
 
 // insertAtMidpoint inserts a node at the head of the "Old" sublist
-func (bp *BufferPool) insertAtMidpoint(node *doublyll.Node) {
+func (bp *BufferPool[K, V]) insertAtMidpoint(node *doublyll.Node[K, V]) {
 
 	// Mark the node as "Old"
 	node.IsOld = true
@@ -67,41 +139,48 @@ func (bp *BufferPool) insertAtMidpoint(node *doublyll.Node) {
 	bp.currentOldSize++
 }
 
-func (bp *BufferPool) Put(key int, value int) {
+func (bp *BufferPool[K, V]) Put(key K, value V) {
+	bp.PutWithTTL(key, value, bp.defaultTTL)
+}
+
+// PutWithTTL inserts or updates key with its own expiration, overriding
+// any default TTL for this entry. A zero ttl means the entry never
+// expires.
+func (bp *BufferPool[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	var expiration time.Time
+	if ttl > 0 {
+		expiration = time.Now().Add(ttl)
+	}
 
 	// 1. Handle Update (If key exists) Treat it as a Get
 	if node, ok := bp.cache[key]; ok {
 		node.Value = value
-        
-        // Exact same promotion logic as Get
-        if node.IsOld {
-            node.IsOld = false
-            bp.currentOldSize--
-            if bp.MidPoint == node {
-                bp.MidPoint = node.Next
-            }
-        }
-        
-        bp.list.RemoveNode(node)
-        bp.list.AddFront(node)
-        return
+		node.Expiration = expiration
+
+		// Exact same promotion logic as Get
+		if node.IsOld {
+			node.IsOld = false
+			bp.currentOldSize--
+			if bp.MidPoint == node {
+				bp.MidPoint = node.Next
+			}
+		}
+
+		bp.list.RemoveNode(node)
+		bp.list.AddFront(node)
+		return
 	}
 
 	// 2. Capacity Check & Eviction
 	if len(bp.cache) == bp.capacity {
-		nodeToEvict := bp.list.Tail
-		bp.list.RemoveNode(nodeToEvict)
-		delete(bp.cache, nodeToEvict.Key)
-		bp.currentOldSize--
-
-		// Update MidPoint if it was evicted
-		if bp.MidPoint == nodeToEvict {
-			bp.MidPoint = nil
-		}
+		bp.removeNode(bp.list.Tail, EvictCapacity)
 	}
 
 	// 3. Create and Insert New Node
-	newNode := &doublyll.Node{Key: key, Value: value}
+	newNode := &doublyll.Node[K, V]{Key: key, Value: value, Expiration: expiration}
 	bp.cache[key] = newNode
 	bp.insertAtMidpoint(newNode) // Use our helper
 
@@ -114,10 +193,20 @@ func (bp *BufferPool) Put(key int, value int) {
 	}
 }
 
-func (bp *BufferPool) Get(key int) int {
+func (bp *BufferPool[K, V]) Get(key K) (V, bool) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
 	node, ok := bp.cache[key]
 	if !ok {
-		return -1
+		var zero V
+		return zero, false
+	}
+
+	if bp.isExpired(node) {
+		bp.removeNode(node, EvictExpired)
+		var zero V
+		return zero, false
 	}
 
 	if node.IsOld {
@@ -131,5 +220,69 @@ func (bp *BufferPool) Get(key int) int {
 	bp.list.RemoveNode(node)
 	bp.list.AddFront(node)
 
-	return node.Value
+	return node.Value, true
+}
+
+// Delete removes key from the cache, firing the OnEvict callback with
+// EvictManual. It reports whether the key was present.
+func (bp *BufferPool[K, V]) Delete(key K) bool {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	node, ok := bp.cache[key]
+	if !ok {
+		return false
+	}
+
+	bp.removeNode(node, EvictManual)
+	return true
+}
+
+func (bp *BufferPool[K, V]) isExpired(node *doublyll.Node[K, V]) bool {
+	return !node.Expiration.IsZero() && time.Now().After(node.Expiration)
+}
+
+// removeNode fully evicts node: it unlinks it from the list, the cache map,
+// and the "Old" bookkeeping, then fires the OnEvict callback.
+func (bp *BufferPool[K, V]) removeNode(node *doublyll.Node[K, V], reason EvictReason) {
+	bp.list.RemoveNode(node)
+	delete(bp.cache, node.Key)
+
+	if node.IsOld {
+		bp.currentOldSize--
+	}
+	if bp.MidPoint == node {
+		bp.MidPoint = nil
+	}
+
+	if bp.onEvict != nil {
+		bp.onEvict(node.Key, node.Value, reason)
+	}
+}
+
+func (bp *BufferPool[K, V]) runJanitor() {
+	ticker := time.NewTicker(bp.janitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			bp.sweepExpired()
+		case <-bp.stopJanitor:
+			return
+		}
+	}
+}
+
+func (bp *BufferPool[K, V]) sweepExpired() {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	for node := bp.list.Tail; node != nil; {
+		prev := node.Prev
+		if bp.isExpired(node) {
+			bp.removeNode(node, EvictExpired)
+		}
+		node = prev
+	}
 }
@@ -0,0 +1,31 @@
+package bufferpool_lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBufferPool_PutWithTTLExpires(t *testing.T) {
+	bp := NewBufferPool[string, int](2, 0.7)
+
+	bp.PutWithTTL("a", 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := bp.Get("a"); ok {
+		t.Fatalf("expected key \"a\" to have expired")
+	}
+}
+
+func TestBufferPool_OnEvictFiresOnManualDelete(t *testing.T) {
+	var reasons []EvictReason
+	bp := NewBufferPool[int, int](2, 0.7, WithOnEvict[int, int](func(k int, v int, reason EvictReason) {
+		reasons = append(reasons, reason)
+	}))
+
+	bp.Put(1, 1)
+	bp.Delete(1)
+
+	if len(reasons) != 1 || reasons[0] != EvictManual {
+		t.Fatalf("expected a single EvictManual callback, got %v", reasons)
+	}
+}
@@ -1,28 +1,32 @@
 package doublyll
 
+import "time"
+
 // Node represents a node in the doubly linked list
-type Node struct {
-	Key   int
-	Value int
-	Prev  *Node
-	Next  *Node
-	IsOld bool
+type Node[K comparable, V any] struct {
+	Key        K
+	Value      V
+	Prev       *Node[K, V]
+	Next       *Node[K, V]
+	IsOld      bool
+	Visited    bool
+	Expiration time.Time // zero value means the entry never expires
 }
 
 // DoublyLinkedList represents a doubly linked list
-type DoublyLinkedList struct {
-	Head *Node
-	Tail *Node
+type DoublyLinkedList[K comparable, V any] struct {
+	Head *Node[K, V]
+	Tail *Node[K, V]
 }
 
-func NewDoublyLinkedList() *DoublyLinkedList {
-	return &DoublyLinkedList{
+func NewDoublyLinkedList[K comparable, V any]() *DoublyLinkedList[K, V] {
+	return &DoublyLinkedList[K, V]{
 		Head: nil,
 		Tail: nil,
 	}
 }
 
-func (list *DoublyLinkedList) AddFront(node *Node) {
+func (list *DoublyLinkedList[K, V]) AddFront(node *Node[K, V]) {
 	if list.Head == nil {
 		list.Head = node
 		list.Tail = node
@@ -37,7 +41,7 @@ func (list *DoublyLinkedList) AddFront(node *Node) {
 	list.Head = node
 }
 
-func (list *DoublyLinkedList) RemoveNode(node *Node) {
+func (list *DoublyLinkedList[K, V]) RemoveNode(node *Node[K, V]) {
 	if node.Prev != nil {
 		node.Prev.Next = node.Next
 	}
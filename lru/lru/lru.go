@@ -1,40 +1,136 @@
 package lru
 
-import "github.com/nishanthgowda/btree/lru/doubly-ll"
+import (
+	"sync"
+	"time"
 
-type LRUCache struct {
+	"github.com/nishanthgowda/btree/lru/doubly-ll"
+)
+
+// EvictReason identifies why an entry left the cache, so an OnEvict
+// callback can tell a capacity eviction apart from an expired or manually
+// removed one.
+type EvictReason int
+
+const (
+	EvictCapacity EvictReason = iota
+	EvictExpired
+	EvictManual
+)
+
+// Option configures an LRUCache at construction time.
+type Option[K comparable, V any] func(*LRUCache[K, V])
+
+// WithDefaultTTL makes every Put (not just PutWithTTL) expire after d.
+func WithDefaultTTL[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(c *LRUCache[K, V]) {
+		c.defaultTTL = d
+	}
+}
+
+// WithOnEvict registers a callback fired whenever an entry leaves the
+// cache, whether from capacity pressure, expiration, or Delete.
+func WithOnEvict[K comparable, V any](fn func(key K, value V, reason EvictReason)) Option[K, V] {
+	return func(c *LRUCache[K, V]) {
+		c.onEvict = fn
+	}
+}
+
+// WithJanitor starts a background goroutine that sweeps the whole list,
+// from the tail, for expired entries every interval, so a cache under TTL but low
+// read pressure doesn't stay bloated with dead entries until something
+// happens to Get them. Call Close to stop it.
+func WithJanitor[K comparable, V any](interval time.Duration) Option[K, V] {
+	return func(c *LRUCache[K, V]) {
+		c.janitorInterval = interval
+	}
+}
+
+type LRUCache[K comparable, V any] struct {
+	mu       sync.Mutex
 	capacity int
-	cache    map[int]*doublyll.Node
-	list     *doublyll.DoublyLinkedList
+	cache    map[K]*doublyll.Node[K, V]
+	list     *doublyll.DoublyLinkedList[K, V]
+
+	defaultTTL      time.Duration
+	onEvict         func(key K, value V, reason EvictReason)
+	janitorInterval time.Duration
+	stopJanitor     chan struct{}
+	closeOnce       sync.Once
 }
 
-func NewLRUCache(capacity int) *LRUCache {
-	return &LRUCache{
+func NewLRUCache[K comparable, V any](capacity int, opts ...Option[K, V]) *LRUCache[K, V] {
+	c := &LRUCache[K, V]{
 		capacity: capacity,
-		cache:    make(map[int]*doublyll.Node),
-		list:     doublyll.NewDoublyLinkedList(),
+		cache:    make(map[K]*doublyll.Node[K, V]),
+		list:     doublyll.NewDoublyLinkedList[K, V](),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	if c.janitorInterval > 0 {
+		c.stopJanitor = make(chan struct{})
+		go c.runJanitor()
+	}
+
+	return c
 }
 
-func (lru *LRUCache) Get(key int) int {
+// Close stops the background janitor goroutine, if one was started with
+// WithJanitor. It is safe to call even if no janitor is running.
+func (lru *LRUCache[K, V]) Close() {
+	lru.closeOnce.Do(func() {
+		if lru.stopJanitor != nil {
+			close(lru.stopJanitor)
+		}
+	})
+}
+
+func (lru *LRUCache[K, V]) Get(key K) (V, bool) {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
 
 	node, ok := lru.cache[key]
 	if !ok {
-		return -1
+		var zero V
+		return zero, false
+	}
+
+	if lru.isExpired(node) {
+		lru.removeNode(node, EvictExpired)
+		var zero V
+		return zero, false
 	}
 
 	lru.list.RemoveNode(node)
 	lru.list.AddFront(node)
-	return node.Value
+	return node.Value, true
 }
 
-func (lru *LRUCache) Put(key int, value int) {
+// Put inserts or updates key, expiring it after the cache's default TTL
+// (if one was configured with WithDefaultTTL, otherwise it never expires).
+func (lru *LRUCache[K, V]) Put(key K, value V) {
+	lru.PutWithTTL(key, value, lru.defaultTTL)
+}
 
-	node, ok := lru.cache[key]
+// PutWithTTL inserts or updates key with its own expiration, overriding
+// any default TTL for this entry. A zero ttl means the entry never
+// expires.
+func (lru *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	var expiration time.Time
+	if ttl > 0 {
+		expiration = time.Now().Add(ttl)
+	}
 
 	// If key is already present, update the value and move to front
-	if ok {
+	if node, ok := lru.cache[key]; ok {
 		node.Value = value
+		node.Expiration = expiration
 		lru.list.RemoveNode(node)
 		lru.list.AddFront(node)
 		return
@@ -42,16 +138,71 @@ func (lru *LRUCache) Put(key int, value int) {
 
 	// If cache is full, remove the least recently used item
 	if len(lru.cache) == lru.capacity {
-		delete(lru.cache, lru.list.Tail.Key)
-		lru.list.RemoveNode(lru.list.Tail)
+		lru.removeNode(lru.list.Tail, EvictCapacity)
 	}
 
-	newNode := &doublyll.Node{
-		Key:   key,
-		Value: value,
+	newNode := &doublyll.Node[K, V]{
+		Key:        key,
+		Value:      value,
+		Expiration: expiration,
 	}
 
 	// Add the new item to the front of the list
 	lru.cache[key] = newNode
 	lru.list.AddFront(newNode)
 }
+
+// Delete removes key from the cache, firing the OnEvict callback with
+// EvictManual. It reports whether the key was present.
+func (lru *LRUCache[K, V]) Delete(key K) bool {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	node, ok := lru.cache[key]
+	if !ok {
+		return false
+	}
+
+	lru.removeNode(node, EvictManual)
+	return true
+}
+
+func (lru *LRUCache[K, V]) isExpired(node *doublyll.Node[K, V]) bool {
+	return !node.Expiration.IsZero() && time.Now().After(node.Expiration)
+}
+
+func (lru *LRUCache[K, V]) removeNode(node *doublyll.Node[K, V], reason EvictReason) {
+	lru.list.RemoveNode(node)
+	delete(lru.cache, node.Key)
+
+	if lru.onEvict != nil {
+		lru.onEvict(node.Key, node.Value, reason)
+	}
+}
+
+func (lru *LRUCache[K, V]) runJanitor() {
+	ticker := time.NewTicker(lru.janitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			lru.sweepExpired()
+		case <-lru.stopJanitor:
+			return
+		}
+	}
+}
+
+func (lru *LRUCache[K, V]) sweepExpired() {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	for node := lru.list.Tail; node != nil; {
+		prev := node.Prev
+		if lru.isExpired(node) {
+			lru.removeNode(node, EvictExpired)
+		}
+		node = prev
+	}
+}
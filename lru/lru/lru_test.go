@@ -0,0 +1,62 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUCache_PutWithTTLExpires(t *testing.T) {
+	cache := NewLRUCache[string, int](2)
+
+	cache.PutWithTTL("a", 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Fatalf("expected key \"a\" to have expired")
+	}
+}
+
+func TestLRUCache_OnEvictFiresForCapacityAndManualRemoval(t *testing.T) {
+	var reasons []EvictReason
+	cache := NewLRUCache[int, int](1, WithOnEvict[int, int](func(k int, v int, reason EvictReason) {
+		reasons = append(reasons, reason)
+	}))
+
+	cache.Put(1, 1)
+	cache.Put(2, 2) // evicts key 1 on capacity pressure
+	cache.Delete(2) // manual removal
+
+	if len(reasons) != 2 {
+		t.Fatalf("expected 2 eviction callbacks, got %d (%v)", len(reasons), reasons)
+	}
+	if reasons[0] != EvictCapacity {
+		t.Errorf("expected first eviction reason to be EvictCapacity, got %v", reasons[0])
+	}
+	if reasons[1] != EvictManual {
+		t.Errorf("expected second eviction reason to be EvictManual, got %v", reasons[1])
+	}
+}
+
+func TestLRUCache_WithJanitorSweepsExpiredEntries(t *testing.T) {
+	evicted := make(chan string, 1)
+	cache := NewLRUCache[string, int](10,
+		WithOnEvict[string, int](func(k string, v int, reason EvictReason) {
+			if reason == EvictExpired {
+				evicted <- k
+			}
+		}),
+		WithJanitor[string, int](2*time.Millisecond),
+	)
+	defer cache.Close()
+
+	cache.PutWithTTL("a", 1, time.Millisecond)
+
+	select {
+	case k := <-evicted:
+		if k != "a" {
+			t.Fatalf("expected the janitor to sweep key \"a\", got %q", k)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the janitor to sweep the expired key")
+	}
+}
@@ -0,0 +1,83 @@
+package sharded
+
+import (
+	"fmt"
+	"hash/maphash"
+)
+
+// Cache is the common Get/Put shape shared by LRUCache, BufferPool,
+// SieveCache, and ARCCache - anything that can sit behind a shard.
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+}
+
+// ShardedCache hashes each key to one of N independent Cache instances so
+// keys on different shards never contend on the same lock, the same approach
+// goleveldb's cache uses to scale write-heavy workloads.
+type ShardedCache[K comparable, V any] struct {
+	shards []Cache[K, V]
+	seed   maphash.Seed
+	hasher func(K) uint64
+}
+
+// NewShardedCache builds a ShardedCache of the given number of shards, each
+// an independent Cache[K, V] of capacity perShardCap produced by factory.
+// Keys are routed to shards with hash/maphash: string and []byte keys are
+// hashed directly, any other comparable K is hashed via its fmt.Sprintf
+// representation. Use NewShardedCacheWithHasher to supply a faster,
+// type-specific hash instead.
+func NewShardedCache[K comparable, V any](shards int, perShardCap int, factory func(cap int) Cache[K, V]) Cache[K, V] {
+	return newShardedCache[K, V](shards, perShardCap, factory, nil)
+}
+
+// NewShardedCacheWithHasher is NewShardedCache with an explicit key hasher,
+// for callers who want to avoid the fmt.Sprintf fallback for arbitrary K.
+func NewShardedCacheWithHasher[K comparable, V any](shards int, perShardCap int, factory func(cap int) Cache[K, V], hasher func(K) uint64) Cache[K, V] {
+	return newShardedCache[K, V](shards, perShardCap, factory, hasher)
+}
+
+func newShardedCache[K comparable, V any](shards int, perShardCap int, factory func(cap int) Cache[K, V], hasher func(K) uint64) *ShardedCache[K, V] {
+	sc := &ShardedCache[K, V]{
+		shards: make([]Cache[K, V], shards),
+		seed:   maphash.MakeSeed(),
+		hasher: hasher,
+	}
+	for i := range sc.shards {
+		sc.shards[i] = factory(perShardCap)
+	}
+	return sc
+}
+
+func (s *ShardedCache[K, V]) Get(key K) (V, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+func (s *ShardedCache[K, V]) Put(key K, value V) {
+	s.shardFor(key).Put(key, value)
+}
+
+func (s *ShardedCache[K, V]) shardFor(key K) Cache[K, V] {
+	idx := s.hash(key) % uint64(len(s.shards))
+	return s.shards[idx]
+}
+
+func (s *ShardedCache[K, V]) hash(key K) uint64 {
+	if s.hasher != nil {
+		return s.hasher(key)
+	}
+
+	var h maphash.Hash
+	h.SetSeed(s.seed)
+
+	switch v := any(key).(type) {
+	case string:
+		h.WriteString(v)
+	case []byte:
+		h.Write(v)
+	default:
+		h.WriteString(fmt.Sprintf("%v", v))
+	}
+
+	return h.Sum64()
+}
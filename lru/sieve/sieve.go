@@ -0,0 +1,87 @@
+package sieve
+
+import (
+	"sync"
+
+	doublyll "github.com/nishanthgowda/btree/lru/doubly-ll"
+)
+
+// SieveCache implements the SIEVE eviction policy: a single FIFO list plus a
+// "hand" pointer and a one-bit Visited flag per node. Unlike LRU, a Get hit
+// never moves the node - it only flips Visited, which is what makes SIEVE
+// cheaper than LRU under read-heavy workloads.
+type SieveCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	cache    map[K]*doublyll.Node[K, V]
+	list     *doublyll.DoublyLinkedList[K, V]
+	hand     *doublyll.Node[K, V]
+}
+
+func NewSieveCache[K comparable, V any](capacity int) *SieveCache[K, V] {
+	return &SieveCache[K, V]{
+		capacity: capacity,
+		cache:    make(map[K]*doublyll.Node[K, V]),
+		list:     doublyll.NewDoublyLinkedList[K, V](),
+	}
+}
+
+func (s *SieveCache[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	node, ok := s.cache[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	node.Visited = true
+	return node.Value, true
+}
+
+func (s *SieveCache[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if node, ok := s.cache[key]; ok {
+		node.Value = value
+		node.Visited = true
+		return
+	}
+
+	if len(s.cache) == s.capacity {
+		s.evict()
+	}
+
+	newNode := &doublyll.Node[K, V]{Key: key, Value: value}
+	s.cache[key] = newNode
+	s.list.AddFront(newNode)
+}
+
+// evict walks backward from the hand (or the tail if the hand is nil),
+// clearing Visited flags along the way until it finds a node whose Visited
+// bit is already false. That node is the victim; the hand is left pointing
+// at its predecessor so the next eviction resumes from there.
+func (s *SieveCache[K, V]) evict() {
+	node := s.hand
+	if node == nil {
+		node = s.list.Tail
+	}
+
+	for node != nil && node.Visited {
+		node.Visited = false
+		node = node.Prev
+		if node == nil {
+			node = s.list.Tail
+		}
+	}
+
+	if node == nil {
+		return
+	}
+
+	s.hand = node.Prev
+	s.list.RemoveNode(node)
+	delete(s.cache, node.Key)
+}
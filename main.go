@@ -7,7 +7,7 @@ import (
 )
 
 func main() {
-	lruCache := lru.NewLRUCache(2)
+	lruCache := lru.NewLRUCache[int, int](2)
 	lruCache.Put(1, 1)
 	lruCache.Put(2, 2)
 	fmt.Println(lruCache.Get(1))